@@ -0,0 +1,18 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+// ForCountry returns the currency currently used as primary circulating
+// tender in the country identified by cc, an ISO 3166-1 alpha-2 code such
+// as "US".
+func ForCountry(cc string) (string, bool) {
+	code, ok := countryCurrency[cc]
+	return code, ok
+}
+
+// Countries returns the countries (ISO 3166-1 alpha-2 codes) that
+// currently use code as circulating tender.
+func Countries(code string) []string {
+	return currencyCountries[code]
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Style controls how Formatter renders an amount.
+type Style int
+
+const (
+	// StyleISO renders the amount followed by the currency's ISO 4217
+	// code, e.g. "1,234.50 USD".
+	StyleISO Style = iota
+	// StyleSymbol renders the amount using the currency's locale symbol,
+	// e.g. "$1,234.50".
+	StyleSymbol
+	// StyleNarrowSymbol renders the amount using the currency's narrow
+	// locale symbol, falling back to StyleSymbol where none is defined,
+	// e.g. "$1,234.50" for both USD and CAD in "en-CA".
+	StyleNarrowSymbol
+)
+
+// Symbol returns the symbol used to represent code in locale, e.g. "$"
+// for USD in "en". Falls back through locale's ancestors, then to code
+// itself if no symbol is known.
+func Symbol(code, locale string) (string, bool) {
+	for _, loc := range localeChain(locale) {
+		if info, ok := symbols[loc][code]; ok && info.symbol != "" {
+			return info.symbol, true
+		}
+	}
+
+	return code, false
+}
+
+// NarrowSymbol returns the narrow symbol used to represent code in
+// locale, e.g. "$" for both USD and CAD in "en-CA". Falls back to the
+// regular symbol, then to code itself if neither is known.
+func NarrowSymbol(code, locale string) (string, bool) {
+	for _, loc := range localeChain(locale) {
+		if info, ok := symbols[loc][code]; ok && info.narrowSymbol != "" {
+			return info.narrowSymbol, true
+		}
+	}
+
+	return Symbol(code, locale)
+}
+
+// Formatter formats amounts for display in a specific locale.
+//
+// Not safe for concurrent use.
+type Formatter struct {
+	Locale string
+
+	numberingSystem string
+}
+
+// FormatterOption configures a Formatter constructed by NewFormatter.
+type FormatterOption func(*Formatter)
+
+// WithNumberingSystem overrides the numbering system used to render
+// digits (e.g. "latn"), bypassing the locale's default. Useful for
+// rendering plain Western digits in a locale that otherwise defaults to
+// a native numbering system, such as "ar-EG".
+func WithNumberingSystem(id string) FormatterOption {
+	return func(f *Formatter) {
+		f.numberingSystem = id
+	}
+}
+
+// NewFormatter creates a Formatter for the given locale.
+func NewFormatter(locale string, opts ...FormatterOption) *Formatter {
+	f := &Formatter{Locale: locale}
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f
+}
+
+// Format renders amount (e.g. "1234.50") as a currency string for code,
+// using the given style. It is equivalent to the Format function, using
+// f.Locale and f's numbering system.
+func (f *Formatter) Format(amount, code string, style Style) string {
+	return translateDigits(formatPattern(amount, code, f.Locale, style), f.numberingSystemID())
+}
+
+// numberingSystemID returns the numbering system to render digits with:
+// the explicit override if set, otherwise the locale's default, otherwise
+// "latn".
+func (f *Formatter) numberingSystemID() string {
+	if f.numberingSystem != "" {
+		return f.numberingSystem
+	}
+
+	return numberingSystemFor(f.Locale)
+}
+
+// translateDigits replaces the ASCII digits in s with the equivalent
+// glyphs from the given numbering system. s is returned unchanged if the
+// numbering system is unknown.
+func translateDigits(s, numberingSystem string) string {
+	digits, ok := numberingSystems[numberingSystem]
+	if !ok {
+		return s
+	}
+
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			r = digits[r-'0']
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// numberingSystemFor returns the numbering system locale defaults to,
+// falling back through its ancestors, then to "latn".
+func numberingSystemFor(locale string) string {
+	for _, loc := range localeChain(locale) {
+		if id, ok := localeNumberingSystem[loc]; ok {
+			return id
+		}
+	}
+
+	return "latn"
+}
+
+// lookupFormat resolves the number formatting rules for locale, falling
+// back through its ancestors, then to an "en"-like default.
+func lookupFormat(locale string) formatInfo {
+	for _, loc := range localeChain(locale) {
+		if info, ok := localeFormats[loc]; ok {
+			return info
+		}
+	}
+
+	return formatInfo{decimalSep: '.', groupSep: ',', groupingSize: 3, groupingSize2: 3, minusSign: '-', symbolBefore: true}
+}
+
+// Format renders amount (e.g. "-1234.5") as a locale-correct currency
+// string for code: digits are grouped and separated per locale, the
+// currency symbol is placed and spaced per locale (the ISO code of
+// StyleISO always follows the amount, space separated), and negative
+// amounts are parenthesized where locale uses accounting-style negatives
+// (StyleISO always keeps the minus sign, matching how accounting
+// formatting is scoped to the symbol styles in CLDR).
+func Format(amount, code, locale string, style Style) string {
+	return translateDigits(formatPattern(amount, code, locale, style), numberingSystemFor(locale))
+}
+
+// formatPattern renders amount as a locale-correct currency string for
+// code, per Format's rules, but leaves digits untranslated so callers can
+// apply whichever numbering system they need.
+func formatPattern(amount, code, locale string, style Style) string {
+	lf := lookupFormat(locale)
+
+	negative := strings.HasPrefix(amount, "-")
+	if negative {
+		amount = amount[1:]
+	}
+	amount = group(amount, lf)
+
+	var affix string
+	// StyleISO always renders the ISO 4217 code after the amount, space
+	// separated, regardless of where the locale places its currency
+	// symbol (e.g. "1,234.50 USD", never "USD1,234.50").
+	symbolBefore := lf.symbolBefore
+	switch style {
+	case StyleSymbol:
+		affix, _ = Symbol(code, locale)
+	case StyleNarrowSymbol:
+		affix, _ = NarrowSymbol(code, locale)
+	default:
+		affix = code
+		symbolBefore = false
+	}
+	if needsSpacing(affix, symbolBefore, lf) {
+		if symbolBefore {
+			affix += " "
+		} else {
+			affix = " " + affix
+		}
+	}
+
+	rendered := amount + affix
+	if symbolBefore {
+		rendered = affix + amount
+	}
+
+	if negative {
+		if style != StyleISO && lf.accounting {
+			rendered = "(" + rendered + ")"
+		} else {
+			rendered = string(lf.minusSign) + rendered
+		}
+	}
+
+	return rendered
+}
+
+// needsSpacing reports whether affix should be separated from the amount
+// by a space. CLDR only inserts currency spacing where the rune of affix
+// that abuts the amount is a letter: the last rune when affix is a
+// prefix (e.g. "CHF" abuts with "F"), the first rune when it's a suffix
+// (e.g. an ISO code like "USD" abuts with "U"). Symbols like "A$" abut
+// with "$" and get no space even though they contain letters elsewhere.
+// A locale's symbolSpace rule, recorded for its regular currency symbol,
+// still applies otherwise.
+func needsSpacing(affix string, symbolBefore bool, lf formatInfo) bool {
+	if affix == "" {
+		return lf.symbolSpace
+	}
+
+	runes := []rune(affix)
+	boundary := runes[0]
+	if symbolBefore {
+		boundary = runes[len(runes)-1]
+	}
+
+	return unicode.IsLetter(boundary) || lf.symbolSpace
+}
+
+// group inserts lf's group separator to the left of the decimal point,
+// every groupingSize digits for the group closest to the decimal point
+// and every groupingSize2 digits for the groups beyond that (CLDR
+// distinguishes the two so locales such as Hindi can group as "1,23,456"
+// rather than "123,456"), and renders the decimal point as lf's decimal
+// separator.
+func group(amount string, lf formatInfo) string {
+	intPart, fracPart := amount, ""
+	if idx := strings.IndexByte(amount, '.'); idx != -1 {
+		intPart, fracPart = amount[:idx], amount[idx+1:]
+	}
+
+	var grouped strings.Builder
+	for i, d := range []byte(intPart) {
+		remaining := len(intPart) - i
+		if i > 0 && remaining >= int(lf.groupingSize) && (remaining-int(lf.groupingSize))%int(lf.groupingSize2) == 0 {
+			grouped.WriteRune(lf.groupSep)
+		}
+		grouped.WriteByte(d)
+	}
+	if fracPart != "" {
+		grouped.WriteRune(lf.decimalSep)
+		grouped.WriteString(fracPart)
+	}
+
+	return grouped.String()
+}
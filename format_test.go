@@ -0,0 +1,82 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import "testing"
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount string
+		code   string
+		locale string
+		style  Style
+		want   string
+	}{
+		{"ISO style suffixes the code, space separated", "1234.50", "USD", "en", StyleISO, "1,234.50 USD"},
+		{"ISO style always keeps the minus sign", "-1234.50", "USD", "en", StyleISO, "-1,234.50 USD"},
+		{"symbol style groups digits", "1234.50", "USD", "en", StyleSymbol, "$1,234.50"},
+		{"symbol style parenthesizes negatives in accounting locales", "-1234.50", "USD", "en", StyleSymbol, "($1,234.50)"},
+		{"a prefix symbol abutting a non-letter gets no space", "5", "AUD", "en", StyleSymbol, "A$5"},
+		{"a suffix symbol abutting a letter gets a space", "5", "CHF", "de", StyleSymbol, "5 CHF"},
+		{"a locale's own spacing rule still applies to non-letter symbols", "1234.50", "EUR", "de", StyleSymbol, "1.234,50 €"},
+		{"fr groups with a non-breaking space and a comma decimal", "1234.50", "EUR", "fr", StyleSymbol, "1 234,50 €"},
+		{"fr parenthesizes negatives in accounting style", "-1234.50", "EUR", "fr", StyleSymbol, "(1 234,50 €)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Format(tt.amount, tt.code, tt.locale, tt.style)
+			if got != tt.want {
+				t.Errorf("Format(%q, %q, %q, %v) = %q, want %q", tt.amount, tt.code, tt.locale, tt.style, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatGrouping(t *testing.T) {
+	tests := []struct {
+		amount string
+		locale string
+		want   string
+	}{
+		{"1234567.89", "en", "1,234,567.89"},
+		{"100", "en", "100"},
+		{"1000", "en", "1,000"},
+	}
+
+	for _, tt := range tests {
+		got := Format(tt.amount, "USD", tt.locale, StyleISO)
+		want := tt.want + " USD"
+		if got != want {
+			t.Errorf("Format(%q, %q, %q, StyleISO) = %q, want %q", tt.amount, "USD", tt.locale, got, want)
+		}
+	}
+}
+
+func TestFormatNativeDigits(t *testing.T) {
+	got := Format("1234.50", "USD", "ar", StyleSymbol)
+	want := translateDigits("$1,234.50", "arab")
+	if got != want {
+		t.Errorf("Format(%q, %q, %q, StyleSymbol) = %q, want %q", "1234.50", "USD", "ar", got, want)
+	}
+}
+
+func TestFormatterFormatMatchesFormat(t *testing.T) {
+	f := NewFormatter("de")
+	got := f.Format("1234.50", "EUR", StyleSymbol)
+	want := Format("1234.50", "EUR", "de", StyleSymbol)
+	if got != want {
+		t.Errorf("Formatter.Format(%q, %q, StyleSymbol) = %q, want %q (to match Format)", "1234.50", "EUR", got, want)
+	}
+}
+
+func TestFormatterWithNumberingSystem(t *testing.T) {
+	f := NewFormatter("ar", WithNumberingSystem("latn"))
+	got := f.Format("1234.50", "USD", StyleSymbol)
+	want := "$1,234.50"
+	if got != want {
+		t.Errorf("Formatter.Format with WithNumberingSystem(%q) = %q, want %q", "latn", got, want)
+	}
+}
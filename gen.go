@@ -6,23 +6,22 @@
 package main
 
 import (
-	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
-	"os/exec"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 	"text/template"
 	"time"
-)
 
-const assetDir = "raw"
+	"golang.org/x/text/internal/gen"
+	"golang.org/x/text/unicode/cldr"
+)
 
 const dataTemplate = `// Code generated by go generate; DO NOT EDIT.
 //go:generate go run gen.go
@@ -53,6 +52,77 @@ var currencies = map[string]currencyInfo{
 var parentLocales = map[string]string{
 	{{ export .ParentLocales 3 "\t" }}
 }
+
+type symbolInfo struct {
+	symbol       string
+	narrowSymbol string
+}
+
+// symbols holds per-locale currency symbols, keyed by locale then by
+// currency code. Only entries that differ from the parent locale are
+// present; resolve through parentLocales to find an inherited value.
+var symbols = map[string]map[string]symbolInfo{
+	{{ export .Symbols 1 "\t" }}
+}
+
+type nameInfo struct {
+	name  string
+	one   string
+	other string
+}
+
+// names holds per-locale currency display names, keyed by locale then by
+// currency code. Only entries that differ from the parent locale are
+// present; resolve through parentLocales to find an inherited value.
+var names = map[string]map[string]nameInfo{
+	{{ export .Names 1 "\t" }}
+}
+
+// currencyCountries maps a currency code to the countries (ISO 3166-1
+// alpha-2) that currently use it as circulating tender.
+var currencyCountries = map[string][]string{
+	{{ export .CurrencyCountries 3 "\t" }}
+}
+
+// countryCurrency maps a country (ISO 3166-1 alpha-2) to the currency it
+// currently uses as its primary circulating tender.
+var countryCurrency = map[string]string{
+	{{ export .CountryCurrency 3 "\t" }}
+}
+
+// numberingSystems maps a CLDR numeric numbering system id to its 10
+// digit glyphs, in ascending order ('0'..'9' equivalents).
+var numberingSystems = map[string][10]rune{
+	{{ export .NumberingSystems 1 "\t" }}
+}
+
+// localeNumberingSystem maps a locale to the id of the numbering system it
+// defaults to. Locales whose default matches the one they inherit through
+// their parents ("latn" at the root, the common case) are omitted to keep
+// the table small.
+var localeNumberingSystem = map[string]string{
+	{{ export .LocaleNumberingSystem 3 "\t" }}
+}
+
+type formatInfo struct {
+	decimalSep    rune
+	groupSep      rune
+	groupingSize  byte
+	groupingSize2 byte
+	minusSign     rune
+	symbolBefore  bool
+	symbolSpace   bool
+	accounting    bool
+}
+
+// localeFormats holds per-locale number formatting rules (separators,
+// grouping, currency placement and spacing, and whether the locale uses
+// parenthesized negatives in accounting style). Only entries that differ
+// from the parent locale are present; resolve through parentLocales to
+// find an inherited value.
+var localeFormats = map[string]formatInfo{
+	{{ export .LocaleFormats 1 "\t" }}
+}
 `
 
 type currencyInfo struct {
@@ -64,36 +134,81 @@ func (c currencyInfo) GoString() string {
 	return fmt.Sprintf("{%q, %d}", c.numericCode, int(c.digits))
 }
 
+type symbolInfo struct {
+	symbol       string
+	narrowSymbol string
+}
+
+func (s symbolInfo) GoString() string {
+	return fmt.Sprintf("{%q, %q}", s.symbol, s.narrowSymbol)
+}
+
+type nameInfo struct {
+	name  string
+	one   string
+	other string
+}
+
+func (n nameInfo) GoString() string {
+	return fmt.Sprintf("{%q, %q, %q}", n.name, n.one, n.other)
+}
+
+type formatInfo struct {
+	decimalSep    rune
+	groupSep      rune
+	groupingSize  byte
+	groupingSize2 byte
+	minusSign     rune
+	symbolBefore  bool
+	symbolSpace   bool
+	accounting    bool
+}
+
+func (f formatInfo) GoString() string {
+	return fmt.Sprintf("{%q, %q, %d, %d, %q, %t, %t, %t}",
+		f.decimalSep, f.groupSep, f.groupingSize, f.groupingSize2, f.minusSign, f.symbolBefore, f.symbolSpace, f.accounting)
+}
+
 func main() {
-	err := os.Mkdir(assetDir, 0755)
-	if err != nil {
-		log.Fatal(err)
-	}
-	defer os.RemoveAll(assetDir)
+	gen.Init()
 
 	log.Println("Fetching CLDR data...")
-	CLDRVersion, err := fetchCLDR(assetDir)
+	cldrData, err := fetchCLDR()
 	if err != nil {
-		os.RemoveAll(assetDir)
 		log.Fatal(err)
 	}
 
 	log.Println("Fetching ISO data...")
 	currencies, err := fetchISO()
 	if err != nil {
-		os.RemoveAll(assetDir)
 		log.Fatal(err)
 	}
 
 	log.Println("Processing...")
-	err = replaceDigits(currencies, assetDir)
+	err = replaceDigits(currencies, cldrData)
+	if err != nil {
+		log.Fatal(err)
+	}
+	parentLocales, err := generateParentLocales(cldrData)
+	if err != nil {
+		log.Fatal(err)
+	}
+	symbols, err := generateSymbols(cldrData, parentLocales)
+	if err != nil {
+		log.Fatal(err)
+	}
+	names, err := generateNames(cldrData, parentLocales)
+	if err != nil {
+		log.Fatal(err)
+	}
+	currencyCountries, countryCurrency := generateCountryCurrencies(cldrData)
+	numberingSystems := generateNumberingSystems(cldrData)
+	localeNumberingSystem, err := generateLocaleNumberingSystems(cldrData, parentLocales)
 	if err != nil {
-		os.RemoveAll(assetDir)
 		log.Fatal(err)
 	}
-	parentLocales, err := generateParentLocales(assetDir)
+	localeFormats, err := generateLocaleFormats(cldrData, parentLocales)
 	if err != nil {
-		os.RemoveAll(assetDir)
 		log.Fatal(err)
 	}
 
@@ -116,7 +231,6 @@ func main() {
 	os.Remove("data.go")
 	f, err := os.Create("data.go")
 	if err != nil {
-		os.RemoveAll(assetDir)
 		log.Fatal(err)
 	}
 	defer f.Close()
@@ -126,57 +240,55 @@ func main() {
 	}
 	t, err := template.New("data").Funcs(funcMap).Parse(dataTemplate)
 	if err != nil {
-		os.RemoveAll(assetDir)
 		log.Fatal(err)
 	}
 	t.Execute(f, struct {
-		CLDRVersion     string
-		G10Currencies   []string
-		OtherCurrencies []string
-		CurrencyInfo    map[string]*currencyInfo
-		ParentLocales   map[string]string
+		CLDRVersion           string
+		G10Currencies         []string
+		OtherCurrencies       []string
+		CurrencyInfo          map[string]*currencyInfo
+		ParentLocales         map[string]string
+		Symbols               map[string]map[string]symbolInfo
+		Names                 map[string]map[string]nameInfo
+		CurrencyCountries     map[string][]string
+		CountryCurrency       map[string]string
+		NumberingSystems      map[string][10]rune
+		LocaleNumberingSystem map[string]string
+		LocaleFormats         map[string]formatInfo
 	}{
-		CLDRVersion:     CLDRVersion,
-		G10Currencies:   g10Currencies,
-		OtherCurrencies: otherCurrencies,
-		CurrencyInfo:    currencies,
-		ParentLocales:   parentLocales,
+		CLDRVersion:           cldrData.Version(),
+		G10Currencies:         g10Currencies,
+		OtherCurrencies:       otherCurrencies,
+		CurrencyInfo:          currencies,
+		ParentLocales:         parentLocales,
+		Symbols:               symbols,
+		Names:                 names,
+		CurrencyCountries:     currencyCountries,
+		CountryCurrency:       countryCurrency,
+		NumberingSystems:      numberingSystems,
+		LocaleNumberingSystem: localeNumberingSystem,
+		LocaleFormats:         localeFormats,
 	})
 
 	log.Println("Done.")
 }
 
-// fetchCLDR fetches the CLDR data from GitHub and returns its version.
-//
-// The JSON version of the data is used because it is more convenient
-// to parse. See https://github.com/unicode-cldr/cldr-json for details.
-func fetchCLDR(dir string) (string, error) {
-	repos := []string{
-		"https://github.com/unicode-cldr/cldr-core.git",
-		"https://github.com/unicode-cldr/cldr-numbers-full.git",
-	}
-	for _, repo := range repos {
-		cmd := exec.Command("git", "clone", repo)
-		cmd.Dir = dir
-		cmd.Stderr = os.Stderr
-		_, err := cmd.Output()
-		if err != nil {
-			return "", err
-		}
-	}
-
-	data, err := ioutil.ReadFile(dir + "/cldr-core/package.json")
+// fetchCLDR fetches the CLDR core.zip for the pinned CLDR version (see the
+// -cldr flag registered by gen.Init) and decodes the sections this
+// generator needs.
+func fetchCLDR() (*cldr.CLDR, error) {
+	r := gen.OpenCLDRCoreZip()
+	defer r.Close()
+
+	d := &cldr.Decoder{}
+	d.SetDirFilter("supplemental", "main")
+	d.SetSectionFilter("numbers", "currencyData", "parentLocales", "numberingSystems")
+	data, err := d.DecodeZip(r)
 	if err != nil {
-		return "", fmt.Errorf("fetchCLDR: %w", err)
-	}
-	aux := struct {
-		Version string
-	}{}
-	if err := json.Unmarshal(data, &aux); err != nil {
-		return "", fmt.Errorf("fetchCLDR: %w", err)
+		return nil, fmt.Errorf("fetchCLDR: %w", err)
 	}
 
-	return aux.Version, nil
+	return data, nil
 }
 
 // fetchISO fetches currency info from ISO.
@@ -249,26 +361,11 @@ func fetchURL(url string) ([]byte, error) {
 //
 // CLDR data reflects real life usage more closely, specifying 0 digits
 // (instead of 2 in ISO data) for ~14 currencies, such as ALL and RSD.
-func replaceDigits(currencies map[string]*currencyInfo, dir string) error {
-	data, err := ioutil.ReadFile(dir + "/cldr-core/supplemental/currencyData.json")
-	if err != nil {
-		return fmt.Errorf("replaceDigits: %w", err)
-	}
-	aux := struct {
-		Supplemental struct {
-			CurrencyData struct {
-				Fractions map[string]map[string]string
-			}
-		}
-	}{}
-	if err := json.Unmarshal(data, &aux); err != nil {
-		return fmt.Errorf("replaceDigits: %w", err)
-	}
-
-	for currencyCode := range currencies {
-		fractions, ok := aux.Supplemental.CurrencyData.Fractions[currencyCode]
+func replaceDigits(currencies map[string]*currencyInfo, cldrData *cldr.CLDR) error {
+	for _, fraction := range cldrData.Supplemental().CurrencyData.Fractions {
+		currency, ok := currencies[fraction.Iso4217]
 		if ok {
-			currencies[currencyCode].digits = parseDigits(fractions["_digits"])
+			currency.digits = parseDigits(fraction.Digits)
 		}
 	}
 
@@ -279,30 +376,18 @@ func replaceDigits(currencies map[string]*currencyInfo, dir string) error {
 //
 // Ensures ignored locales are skipped.
 // Replaces "root" with "en", since this package treats them as equivalent.
-func generateParentLocales(dir string) (map[string]string, error) {
-	data, err := ioutil.ReadFile(dir + "/cldr-core/supplemental/parentLocales.json")
-	if err != nil {
-		return nil, fmt.Errorf("generateParentLocales: %w", err)
-	}
-	aux := struct {
-		Supplemental struct {
-			ParentLocales struct {
-				ParentLocale map[string]string
-			}
-		}
-	}{}
-	if err := json.Unmarshal(data, &aux); err != nil {
-		return nil, fmt.Errorf("generateParentLocales: %w", err)
-	}
-
+func generateParentLocales(cldrData *cldr.CLDR) (map[string]string, error) {
 	parentLocales := make(map[string]string)
-	for locale, parent := range aux.Supplemental.ParentLocales.ParentLocale {
+	for _, pl := range cldrData.Supplemental().ParentLocales.ParentLocale {
 		// Avoid exposing the concept of "root" to users.
+		parent := pl.Parent
 		if parent == "root" {
 			parent = "en"
 		}
-		if !shouldIgnoreLocale(locale) {
-			parentLocales[locale] = parent
+		for _, locale := range strings.Fields(pl.Locales) {
+			if !shouldIgnoreLocale(locale) {
+				parentLocales[locale] = parent
+			}
 		}
 	}
 	// Dsrt and Shaw are made up scripts.
@@ -312,6 +397,328 @@ func generateParentLocales(dir string) (map[string]string, error) {
 	return parentLocales, nil
 }
 
+// parentLocaleOf returns the locale that locale's generated table entries
+// should be diffed against: CLDR's explicit parent if one is recorded,
+// else locale truncated to its next-shorter subtag, else "en". This
+// mirrors the per-step resolution localeChain performs at runtime, so a
+// value is only dropped when it equals its parent's own value, which
+// fallback then resolves to. A value the parent does not list is kept
+// even where a more distant ancestor has the same value.
+func parentLocaleOf(locale string, parentLocales map[string]string) string {
+	if parent, ok := parentLocales[locale]; ok {
+		return parent
+	}
+	if idx := strings.LastIndex(locale, "-"); idx != -1 {
+		return locale[:idx]
+	}
+
+	return "en"
+}
+
+// generateSymbols generates per-locale currency symbols from CLDR data.
+//
+// Only values that differ from the parent locale are kept, both to
+// minimize the size of the generated data and to allow Symbol/NarrowSymbol
+// to fall back through parentLocales at runtime.
+func generateSymbols(cldrData *cldr.CLDR, parentLocales map[string]string) (map[string]map[string]symbolInfo, error) {
+	raw := make(map[string]map[string]symbolInfo)
+	for _, locale := range cldrData.Locales() {
+		if shouldIgnoreLocale(locale) {
+			continue
+		}
+		ldml, err := cldrData.LDML(locale)
+		if err != nil {
+			return nil, fmt.Errorf("generateSymbols: %w", err)
+		}
+		if ldml.Numbers == nil || ldml.Numbers.Currencies == nil {
+			continue
+		}
+
+		locSymbols := make(map[string]symbolInfo)
+		for _, currency := range ldml.Numbers.Currencies.Currency {
+			var info symbolInfo
+			for _, symbol := range currency.Symbol {
+				if symbol.Alt == "narrow" {
+					info.narrowSymbol = symbol.Data()
+				} else if symbol.Alt == "" {
+					info.symbol = symbol.Data()
+				}
+			}
+			if info.symbol != "" || info.narrowSymbol != "" {
+				locSymbols[currency.Type] = info
+			}
+		}
+		raw[locale] = locSymbols
+	}
+
+	symbols := make(map[string]map[string]symbolInfo)
+	for locale, locSymbols := range raw {
+		delta := make(map[string]symbolInfo)
+		for currencyCode, info := range locSymbols {
+			if info != raw[parentLocaleOf(locale, parentLocales)][currencyCode] {
+				delta[currencyCode] = info
+			}
+		}
+		if len(delta) > 0 {
+			symbols[locale] = delta
+		}
+	}
+
+	return symbols, nil
+}
+
+// generateNames generates per-locale currency display names from CLDR
+// data, including the "one"/"other" plural forms.
+//
+// Only values that differ from the parent locale are kept, both to
+// minimize the size of the generated data and to allow Name/PluralName
+// to fall back through parentLocales at runtime.
+func generateNames(cldrData *cldr.CLDR, parentLocales map[string]string) (map[string]map[string]nameInfo, error) {
+	raw := make(map[string]map[string]nameInfo)
+	for _, locale := range cldrData.Locales() {
+		if shouldIgnoreLocale(locale) {
+			continue
+		}
+		ldml, err := cldrData.LDML(locale)
+		if err != nil {
+			return nil, fmt.Errorf("generateNames: %w", err)
+		}
+		if ldml.Numbers == nil || ldml.Numbers.Currencies == nil {
+			continue
+		}
+
+		locNames := make(map[string]nameInfo)
+		for _, currency := range ldml.Numbers.Currencies.Currency {
+			var info nameInfo
+			for _, dn := range currency.DisplayName {
+				switch dn.Count {
+				case "":
+					info.name = dn.Data()
+				case "one":
+					info.one = dn.Data()
+				case "other":
+					info.other = dn.Data()
+				}
+			}
+			if info != (nameInfo{}) {
+				locNames[currency.Type] = info
+			}
+		}
+		raw[locale] = locNames
+	}
+
+	names := make(map[string]map[string]nameInfo)
+	for locale, locNames := range raw {
+		delta := make(map[string]nameInfo)
+		for currencyCode, info := range locNames {
+			if info != raw[parentLocaleOf(locale, parentLocales)][currencyCode] {
+				delta[currencyCode] = info
+			}
+		}
+		if len(delta) > 0 {
+			names[locale] = delta
+		}
+	}
+
+	return names, nil
+}
+
+// generateCountryCurrencies generates the currency<->country mappings from
+// CLDR's currencyData/region data.
+//
+// Only currently-circulating tender is considered: entries with a "_to"
+// attribute have stopped circulating, and entries with "_tender=false" are
+// non-tender currencies (e.g. used for financial reporting only). Where a
+// country lists more than one currently-circulating currency, the last one
+// in CLDR's (chronological) order is treated as primary.
+func generateCountryCurrencies(cldrData *cldr.CLDR) (map[string][]string, map[string]string) {
+	currencyCountries := make(map[string][]string)
+	countryCurrency := make(map[string]string)
+	for _, region := range cldrData.Supplemental().CurrencyData.Region {
+		for _, currency := range region.Currency {
+			if currency.To != "" || currency.Tender == "false" {
+				continue
+			}
+			currencyCountries[currency.Iso4217] = append(currencyCountries[currency.Iso4217], region.Iso3166)
+			countryCurrency[region.Iso3166] = currency.Iso4217
+		}
+	}
+	for currencyCode := range currencyCountries {
+		sort.Strings(currencyCountries[currencyCode])
+	}
+
+	return currencyCountries, countryCurrency
+}
+
+// generateNumberingSystems generates the digit glyphs for every CLDR
+// numeric numbering system (e.g. "latn", "arab", "deva"). Algorithmic
+// numbering systems (e.g. "hanidec") have no fixed 10-digit glyph table
+// and are skipped.
+func generateNumberingSystems(cldrData *cldr.CLDR) map[string][10]rune {
+	numberingSystems := make(map[string][10]rune)
+	for _, ns := range cldrData.Supplemental().NumberingSystems.NumberingSystem {
+		if ns.Type != "numeric" {
+			continue
+		}
+		digits := []rune(ns.Digits)
+		if len(digits) != 10 {
+			continue
+		}
+		var glyphs [10]rune
+		copy(glyphs[:], digits)
+		numberingSystems[ns.Id] = glyphs
+	}
+
+	return numberingSystems
+}
+
+// generateLocaleNumberingSystems generates the default numbering system
+// per locale, omitting locales whose default matches the one they would
+// inherit through their parents ("latn" at the root; the vast majority)
+// to keep the table small.
+func generateLocaleNumberingSystems(cldrData *cldr.CLDR, parentLocales map[string]string) (map[string]string, error) {
+	raw := make(map[string]string)
+	for _, locale := range cldrData.Locales() {
+		if shouldIgnoreLocale(locale) {
+			continue
+		}
+		ldml, err := cldrData.LDML(locale)
+		if err != nil {
+			return nil, fmt.Errorf("generateLocaleNumberingSystems: %w", err)
+		}
+		if ldml.Numbers == nil || len(ldml.Numbers.DefaultNumberingSystem) == 0 {
+			continue
+		}
+		raw[locale] = ldml.Numbers.DefaultNumberingSystem[0].Data()
+	}
+
+	localeNumberingSystem := make(map[string]string)
+	for locale, system := range raw {
+		inherited := "latn"
+		for parent := parentLocaleOf(locale, parentLocales); ; parent = parentLocaleOf(parent, parentLocales) {
+			if s, ok := raw[parent]; ok {
+				inherited = s
+				break
+			}
+			if parent == "en" {
+				break
+			}
+		}
+		if system != inherited {
+			localeNumberingSystem[locale] = system
+		}
+	}
+
+	return localeNumberingSystem, nil
+}
+
+// generateLocaleFormats generates per-locale number formatting rules from
+// CLDR's "latn" number symbols and currency format patterns.
+//
+// Grouping size, currency placement (symbol before/after the amount) and
+// currency spacing (whether a space separates them) are derived from the
+// "standard" currencyFormat pattern; whether negative amounts are
+// parenthesized is derived from the "accounting" pattern.
+func generateLocaleFormats(cldrData *cldr.CLDR, parentLocales map[string]string) (map[string]formatInfo, error) {
+	raw := make(map[string]formatInfo)
+	for _, locale := range cldrData.Locales() {
+		if shouldIgnoreLocale(locale) {
+			continue
+		}
+		ldml, err := cldrData.LDML(locale)
+		if err != nil {
+			return nil, fmt.Errorf("generateLocaleFormats: %w", err)
+		}
+		if ldml.Numbers == nil {
+			continue
+		}
+		if info, ok := parseFormatInfo(ldml.Numbers); ok {
+			raw[locale] = info
+		}
+	}
+
+	localeFormats := make(map[string]formatInfo)
+	for locale, info := range raw {
+		if info != raw[parentLocaleOf(locale, parentLocales)] {
+			localeFormats[locale] = info
+		}
+	}
+
+	return localeFormats, nil
+}
+
+func parseFormatInfo(numbers *cldr.Numbers) (formatInfo, bool) {
+	info := formatInfo{groupingSize: 3, groupingSize2: 3}
+	for _, symbols := range numbers.Symbols {
+		if symbols.NumberSystem != "latn" {
+			continue
+		}
+		if len(symbols.Decimal) > 0 {
+			info.decimalSep = []rune(symbols.Decimal[0].Data())[0]
+		}
+		if len(symbols.Group) > 0 {
+			info.groupSep = []rune(symbols.Group[0].Data())[0]
+		}
+		if len(symbols.MinusSign) > 0 {
+			info.minusSign = []rune(symbols.MinusSign[0].Data())[0]
+		}
+	}
+	if info.decimalSep == 0 && info.groupSep == 0 {
+		return info, false
+	}
+
+	for _, currencyFormats := range numbers.CurrencyFormats {
+		if currencyFormats.NumberSystem != "latn" {
+			continue
+		}
+		for _, length := range currencyFormats.CurrencyFormatLength {
+			for _, format := range length.CurrencyFormat {
+				if len(format.Pattern) == 0 {
+					continue
+				}
+				pattern := format.Pattern[0].Data()
+				switch format.Type {
+				case "", "standard":
+					info.symbolBefore = strings.HasPrefix(pattern, "¤")
+					info.symbolSpace = strings.Contains(pattern, "¤ ") || strings.Contains(pattern, " ¤")
+					info.groupingSize, info.groupingSize2 = groupingSizes(pattern)
+				case "accounting":
+					info.accounting = strings.Contains(pattern, "(")
+				}
+			}
+		}
+	}
+
+	return info, true
+}
+
+// groupingSizes derives the primary and secondary grouping sizes from the
+// integer portion of a CLDR number pattern such as "#,##,##0.00" (primary
+// 3, secondary 2) or "#,##0.00" (primary 3, secondary 3). Patterns with no
+// grouping, or with only one group, use the primary size for both.
+func groupingSizes(pattern string) (byte, byte) {
+	intPart := pattern
+	if idx := strings.IndexByte(intPart, '.'); idx != -1 {
+		intPart = intPart[:idx]
+	}
+	if idx := strings.IndexByte(intPart, ';'); idx != -1 {
+		intPart = intPart[:idx]
+	}
+
+	groups := strings.Split(intPart, ",")
+	if len(groups) < 2 {
+		return 3, 3
+	}
+
+	primary := byte(len(groups[len(groups)-1]))
+	secondary := primary
+	if len(groups) >= 3 {
+		secondary = byte(len(groups[len(groups)-2]))
+	}
+
+	return primary, secondary
+}
+
 func shouldIgnoreLocale(locale string) bool {
 	ignoredLocales := []string{
 		// Esperanto, Interlingua, Volapuk are made up languages.
@@ -394,7 +801,11 @@ func exportMap(v reflect.Value, width int, indent string) string {
 	i := 0
 	for _, key := range keys {
 		value := v.MapIndex(reflect.ValueOf(key))
-		fmt.Fprintf(&b, `%q: %#v,`, key, value)
+		if value.Kind() == reflect.Map {
+			fmt.Fprintf(&b, "%q: {\n%s\t%s\n%s},", key, indent, exportMap(value, width, indent+"\t"), indent)
+		} else {
+			fmt.Fprintf(&b, `%q: %#v,`, key, value)
+		}
 		if i+1 != v.Len() {
 			if (i+1)%width == 0 {
 				b.WriteString("\n")
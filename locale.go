@@ -0,0 +1,29 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import "strings"
+
+// localeChain returns locale followed by its ancestors, as resolved via
+// parentLocales, ending with "en" (the root equivalent).
+//
+// Locales not present in parentLocales fall back to progressively
+// shorter subtags (e.g. "fr-CA" falls back to "fr") before reaching "en".
+func localeChain(locale string) []string {
+	chain := []string{locale}
+	for locale != "en" {
+		parent, ok := parentLocales[locale]
+		if !ok {
+			if idx := strings.LastIndex(locale, "-"); idx != -1 {
+				parent = locale[:idx]
+			} else {
+				parent = "en"
+			}
+		}
+		chain = append(chain, parent)
+		locale = parent
+	}
+
+	return chain
+}
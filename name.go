@@ -0,0 +1,46 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+// Name returns the display name used for code in locale, such as
+// "US Dollar" for USD in "en". Falls back through locale's ancestors,
+// then to code itself if no display name is known.
+func Name(code, locale string) string {
+	for _, loc := range localeChain(locale) {
+		if info, ok := names[loc][code]; ok && info.name != "" {
+			return info.name
+		}
+	}
+
+	return code
+}
+
+// PluralName returns the display name used for code in locale, pluralized
+// for count, such as "US dollar" for 1 USD or "US dollars" for 3 USD in
+// "en". Falls back through locale's ancestors, then to Name.
+//
+// Plural category selection currently only distinguishes "one" (count == 1)
+// from "other" (everything else), which covers English and most European
+// languages but not languages with richer plural systems.
+func PluralName(code, locale string, count int) string {
+	category := "other"
+	if count == 1 {
+		category = "one"
+	}
+
+	for _, loc := range localeChain(locale) {
+		info, ok := names[loc][code]
+		if !ok {
+			continue
+		}
+		if category == "one" && info.one != "" {
+			return info.one
+		}
+		if category == "other" && info.other != "" {
+			return info.other
+		}
+	}
+
+	return Name(code, locale)
+}